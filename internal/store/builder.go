@@ -0,0 +1,139 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"k8s.io/kube-state-metrics/pkg/metricsstore"
+	"k8s.io/kube-state-metrics/pkg/options"
+
+	autoscaling "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Builder turns a set of enabled collectors into the cache.Store instances
+// that back them, gating CRD-backed collectors on what the cluster actually
+// serves.
+type Builder struct {
+	kubeClient        clientset.Interface
+	dynamicClient     dynamic.Interface
+	namespaces        []string
+	enabledCollectors options.CollectorSet
+	ctx               context.Context
+}
+
+// NewBuilder returns a Builder that, absent further configuration, builds no
+// stores: callers must set a kube client and the collectors to enable.
+func NewBuilder() *Builder {
+	return &Builder{
+		namespaces: []string{metav1.NamespaceAll},
+		ctx:        context.Background(),
+	}
+}
+
+func (b *Builder) WithKubeClient(c clientset.Interface) *Builder {
+	b.kubeClient = c
+	return b
+}
+
+func (b *Builder) WithDynamicClient(c dynamic.Interface) *Builder {
+	b.dynamicClient = c
+	return b
+}
+
+func (b *Builder) WithNamespaces(namespaces []string) *Builder {
+	b.namespaces = namespaces
+	return b
+}
+
+func (b *Builder) WithEnabledCollectors(c options.CollectorSet) *Builder {
+	b.enabledCollectors = c
+	return b
+}
+
+// Build starts a reflector per enabled, available collector and namespace and
+// returns the cache.Store instances they populate.
+func (b *Builder) Build() []cache.Store {
+	var stores []cache.Store
+
+	if b.enabledCollectors.IsEnabled(options.CollectorHorizontalPodAutoscalers) {
+		stores = append(stores, b.buildHPAStores()...)
+	}
+	if b.enabledCollectors.IsEnabled(options.CollectorScaledObjects) {
+		stores = append(stores, b.buildScaledObjectStores()...)
+	}
+
+	return stores
+}
+
+func (b *Builder) buildHPAStores() []cache.Store {
+	stores := make([]cache.Store, 0, len(b.namespaces))
+	for _, ns := range b.namespaces {
+		store := metricsstore.NewMetricsStore(hpaMetricFamilies)
+		reflector := cache.NewReflector(createHPAListWatch(b.kubeClient, ns), &autoscaling.HorizontalPodAutoscaler{}, store, 0)
+		go reflector.Run(b.ctx.Done())
+		stores = append(stores, store)
+	}
+	return stores
+}
+
+// buildScaledObjectStores wires up the KEDA-backed ScaledObject/ScaledJob
+// stores, skipping each CRD that discovery says isn't served so clusters
+// without KEDA installed don't fail to start.
+func (b *Builder) buildScaledObjectStores() []cache.Store {
+	var stores []cache.Store
+
+	haveScaledObjects := scaledObjectCRDAvailable(b.kubeClient.Discovery())
+	haveScaledJobs := scaledJobCRDAvailable(b.kubeClient.Discovery())
+
+	if !haveScaledObjects && !haveScaledJobs {
+		log.Println("keda.sh/v1alpha1 CRDs not found, skipping scaledobjects collector")
+		return nil
+	}
+
+	for _, ns := range b.namespaces {
+		if haveScaledObjects {
+			store := metricsstore.NewMetricsStore(scaledObjectMetricFamilies)
+			reflector := cache.NewReflector(createScaledObjectListWatch(b.dynamicClient, ns), &unstructured.Unstructured{}, store, time.Duration(0))
+			go reflector.Run(b.ctx.Done())
+			stores = append(stores, store)
+		}
+
+		if haveScaledJobs {
+			store := metricsstore.NewMetricsStore(scaledJobMetricFamilies)
+			reflector := cache.NewReflector(createScaledJobListWatch(b.dynamicClient, ns), &unstructured.Unstructured{}, store, time.Duration(0))
+			go reflector.Run(b.ctx.Done())
+			stores = append(stores, store)
+		}
+	}
+
+	if !haveScaledObjects {
+		log.Println("keda.sh/v1alpha1 ScaledObjects CRD not found, skipping ScaledObject metrics")
+	}
+	if !haveScaledJobs {
+		log.Println("keda.sh/v1alpha1 ScaledJobs CRD not found, skipping ScaledJob metrics")
+	}
+
+	return stores
+}
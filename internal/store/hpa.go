@@ -17,12 +17,18 @@ limitations under the License.
 package store
 
 import (
+	"strconv"
+
 	"k8s.io/kube-state-metrics/pkg/metric"
 
-	autoscaling "k8s.io/api/autoscaling/v2beta2"
+	autoscaling "k8s.io/api/autoscaling/v2"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 )
@@ -163,6 +169,84 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_hpa_spec_behavior_scaling_stabilization_window_seconds",
+			Type: metric.Gauge,
+			Help: "Duration in seconds the autoscaler has to look back for the given direction. No series is emitted if not set.",
+			GenerateFunc: wrapHPAFunc(func(a *autoscaling.HorizontalPodAutoscaler) *metric.Family {
+				return &metric.Family{
+					Metrics: generateHPABehaviorStabilizationWindowMetrics(a.Spec.Behavior),
+				}
+			}),
+		},
+		{
+			Name: "kube_hpa_spec_behavior_scaling_select_policy",
+			Type: metric.Gauge,
+			Help: "The policy used to make scaling decisions for the given direction.",
+			GenerateFunc: wrapHPAFunc(func(a *autoscaling.HorizontalPodAutoscaler) *metric.Family {
+				return &metric.Family{
+					Metrics: generateHPABehaviorSelectPolicyMetrics(a.Spec.Behavior),
+				}
+			}),
+		},
+		{
+			Name: "kube_hpa_spec_behavior_scaling_policy",
+			Type: metric.Gauge,
+			Help: "One series per scaling policy entry for the given direction, with the policy's value.",
+			GenerateFunc: wrapHPAFunc(func(a *autoscaling.HorizontalPodAutoscaler) *metric.Family {
+				return &metric.Family{
+					Metrics: generateHPABehaviorPolicyMetrics(a.Spec.Behavior),
+				}
+			}),
+		},
+		{
+			Name: "kube_hpa_status_last_scale_time",
+			Type: metric.Gauge,
+			Help: "Unix timestamp of the last time the HorizontalPodAutoscaler scaled the number of pods.",
+			GenerateFunc: wrapHPAFunc(func(a *autoscaling.HorizontalPodAutoscaler) *metric.Family {
+				ms := make([]*metric.Metric, 0)
+				if t := a.Status.LastScaleTime; t != nil {
+					ms = append(ms, &metric.Metric{
+						Value: float64(t.Unix()),
+					})
+				}
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_hpa_status_observed_generation",
+			Type: metric.Gauge,
+			Help: "The generation last observed by the HorizontalPodAutoscaler controller.",
+			GenerateFunc: wrapHPAFunc(func(a *autoscaling.HorizontalPodAutoscaler) *metric.Family {
+				ms := make([]*metric.Metric, 0)
+				if g := a.Status.ObservedGeneration; g != nil {
+					ms = append(ms, &metric.Metric{
+						Value: float64(*g),
+					})
+				}
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_hpa_generation_lag",
+			Type: metric.Gauge,
+			Help: "Difference between the HorizontalPodAutoscaler's metadata generation and the generation last observed by its controller; a persistently non-zero value indicates a stuck HPA controller.",
+			GenerateFunc: wrapHPAFunc(func(a *autoscaling.HorizontalPodAutoscaler) *metric.Family {
+				ms := make([]*metric.Metric, 0)
+				if g := a.Status.ObservedGeneration; g != nil {
+					ms = append(ms, &metric.Metric{
+						Value: float64(a.ObjectMeta.Generation - *g),
+					})
+				}
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
 	}
 )
 
@@ -181,15 +265,341 @@ func wrapHPAFunc(f func(*autoscaling.HorizontalPodAutoscaler) *metric.Family) fu
 	}
 }
 
+var (
+	autoscalingV2Version      = schema.GroupVersion{Group: "autoscaling", Version: "v2"}
+	autoscalingV2beta2Version = schema.GroupVersion{Group: "autoscaling", Version: "v2beta2"}
+	autoscalingV1Version      = schema.GroupVersion{Group: "autoscaling", Version: "v1"}
+)
+
+// preferredAutoscalingVersion asks discovery which of the HPA API versions
+// kube-state-metrics knows how to read is actually served by the apiserver,
+// preferring autoscaling/v2 and falling back through v2beta2 to v1, which is
+// served by every supported cluster.
+func preferredAutoscalingVersion(disc discovery.DiscoveryInterface) schema.GroupVersion {
+	for _, gv := range []schema.GroupVersion{autoscalingV2Version, autoscalingV2beta2Version} {
+		if _, err := disc.ServerResourcesForGroupVersion(gv.String()); err == nil {
+			return gv
+		}
+	}
+	return autoscalingV1Version
+}
+
 func createHPAListWatch(kubeClient clientset.Interface, ns string) cache.ListerWatcher {
-	return &cache.ListWatch{
-		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
-			return kubeClient.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).List(opts)
+	switch preferredAutoscalingVersion(kubeClient.Discovery()) {
+	case autoscalingV2beta2Version:
+		return &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				list, err := kubeClient.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).List(opts)
+				if err != nil {
+					return nil, err
+				}
+				return convertV2beta2HPAList(list), nil
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				w, err := kubeClient.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).Watch(opts)
+				if err != nil {
+					return nil, err
+				}
+				return newConvertingWatcher(w, func(obj runtime.Object) (runtime.Object, bool) {
+					hpa, ok := obj.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+					if !ok {
+						return obj, false
+					}
+					return convertV2beta2HPA(hpa), true
+				}), nil
+			},
+		}
+	case autoscalingV1Version:
+		return &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				list, err := kubeClient.AutoscalingV1().HorizontalPodAutoscalers(ns).List(opts)
+				if err != nil {
+					return nil, err
+				}
+				return convertV1HPAList(list), nil
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				w, err := kubeClient.AutoscalingV1().HorizontalPodAutoscalers(ns).Watch(opts)
+				if err != nil {
+					return nil, err
+				}
+				return newConvertingWatcher(w, func(obj runtime.Object) (runtime.Object, bool) {
+					hpa, ok := obj.(*autoscalingv1.HorizontalPodAutoscaler)
+					if !ok {
+						return obj, false
+					}
+					return convertV1HPA(hpa), true
+				}), nil
+			},
+		}
+	default:
+		return &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return kubeClient.AutoscalingV2().HorizontalPodAutoscalers(ns).List(opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return kubeClient.AutoscalingV2().HorizontalPodAutoscalers(ns).Watch(opts)
+			},
+		}
+	}
+}
+
+// convertingWatcher adapts a watch.Interface whose events carry objects of an
+// older autoscaling API version into one that emits the internal v2
+// representation hpaMetricFamilies is written against, so a single set of
+// GenerateFuncs works regardless of the version actually served. convert
+// reports ok=false for anything it doesn't recognize (e.g. the *metav1.Status
+// carried by watch.Error events on a 410 "resource version too old"), and
+// those objects pass through unconverted rather than panicking the watcher.
+type convertingWatcher struct {
+	source  watch.Interface
+	convert func(runtime.Object) (runtime.Object, bool)
+	out     chan watch.Event
+}
+
+func newConvertingWatcher(source watch.Interface, convert func(runtime.Object) (runtime.Object, bool)) watch.Interface {
+	w := &convertingWatcher{
+		source:  source,
+		convert: convert,
+		out:     make(chan watch.Event),
+	}
+	go w.run()
+	return w
+}
+
+func (w *convertingWatcher) run() {
+	defer close(w.out)
+	for evt := range w.source.ResultChan() {
+		if evt.Object != nil {
+			if converted, ok := w.convert(evt.Object); ok {
+				evt.Object = converted
+			}
+		}
+		w.out <- evt
+	}
+}
+
+func (w *convertingWatcher) Stop() {
+	w.source.Stop()
+}
+
+func (w *convertingWatcher) ResultChan() <-chan watch.Event {
+	return w.out
+}
+
+func convertV2beta2HPAList(in *autoscalingv2beta2.HorizontalPodAutoscalerList) *autoscaling.HorizontalPodAutoscalerList {
+	out := &autoscaling.HorizontalPodAutoscalerList{ListMeta: in.ListMeta}
+	for i := range in.Items {
+		out.Items = append(out.Items, *convertV2beta2HPA(&in.Items[i]))
+	}
+	return out
+}
+
+func convertV2beta2HPA(in *autoscalingv2beta2.HorizontalPodAutoscaler) *autoscaling.HorizontalPodAutoscaler {
+	out := &autoscaling.HorizontalPodAutoscaler{
+		ObjectMeta: in.ObjectMeta,
+		Spec: autoscaling.HorizontalPodAutoscalerSpec{
+			MaxReplicas: in.Spec.MaxReplicas,
+			MinReplicas: in.Spec.MinReplicas,
+		},
+		Status: autoscaling.HorizontalPodAutoscalerStatus{
+			CurrentReplicas:    in.Status.CurrentReplicas,
+			DesiredReplicas:    in.Status.DesiredReplicas,
+			LastScaleTime:      in.Status.LastScaleTime,
+			ObservedGeneration: in.Status.ObservedGeneration,
+		},
+	}
+	for _, ms := range in.Spec.Metrics {
+		out.Spec.Metrics = append(out.Spec.Metrics, convertV2beta2MetricSpec(ms))
+	}
+	for _, ms := range in.Status.CurrentMetrics {
+		out.Status.CurrentMetrics = append(out.Status.CurrentMetrics, convertV2beta2MetricStatus(ms))
+	}
+	for _, c := range in.Status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, autoscaling.HorizontalPodAutoscalerCondition{
+			Type:    autoscaling.HorizontalPodAutoscalerConditionType(c.Type),
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	out.Spec.Behavior = convertV2beta2Behavior(in.Spec.Behavior)
+	return out
+}
+
+func convertV2beta2Behavior(in *autoscalingv2beta2.HorizontalPodAutoscalerBehavior) *autoscaling.HorizontalPodAutoscalerBehavior {
+	if in == nil {
+		return nil
+	}
+	return &autoscaling.HorizontalPodAutoscalerBehavior{
+		ScaleUp:   convertV2beta2ScalingRules(in.ScaleUp),
+		ScaleDown: convertV2beta2ScalingRules(in.ScaleDown),
+	}
+}
+
+func convertV2beta2ScalingRules(in *autoscalingv2beta2.HPAScalingRules) *autoscaling.HPAScalingRules {
+	if in == nil {
+		return nil
+	}
+	out := &autoscaling.HPAScalingRules{
+		StabilizationWindowSeconds: in.StabilizationWindowSeconds,
+	}
+	if in.SelectPolicy != nil {
+		policy := autoscaling.ScalingPolicySelect(*in.SelectPolicy)
+		out.SelectPolicy = &policy
+	}
+	for _, p := range in.Policies {
+		out.Policies = append(out.Policies, autoscaling.HPAScalingPolicy{
+			Type:          autoscaling.HPAScalingPolicyType(p.Type),
+			Value:         p.Value,
+			PeriodSeconds: p.PeriodSeconds,
+		})
+	}
+	return out
+}
+
+func convertV2beta2MetricTarget(in autoscalingv2beta2.MetricTarget) autoscaling.MetricTarget {
+	return autoscaling.MetricTarget{
+		Type:               autoscaling.MetricTargetType(in.Type),
+		Value:              in.Value,
+		AverageValue:       in.AverageValue,
+		AverageUtilization: in.AverageUtilization,
+	}
+}
+
+func convertV2beta2MetricValueStatus(in autoscalingv2beta2.MetricValueStatus) autoscaling.MetricValueStatus {
+	return autoscaling.MetricValueStatus{
+		Value:              in.Value,
+		AverageValue:       in.AverageValue,
+		AverageUtilization: in.AverageUtilization,
+	}
+}
+
+func convertV2beta2MetricSpec(in autoscalingv2beta2.MetricSpec) autoscaling.MetricSpec {
+	out := autoscaling.MetricSpec{Type: autoscaling.MetricSourceType(in.Type)}
+	if in.Resource != nil {
+		out.Resource = &autoscaling.ResourceMetricSource{
+			Name:   in.Resource.Name,
+			Target: convertV2beta2MetricTarget(in.Resource.Target),
+		}
+	}
+	if in.ContainerResource != nil {
+		out.ContainerResource = &autoscaling.ContainerResourceMetricSource{
+			Name:      in.ContainerResource.Name,
+			Container: in.ContainerResource.Container,
+			Target:    convertV2beta2MetricTarget(in.ContainerResource.Target),
+		}
+	}
+	if in.Pods != nil {
+		out.Pods = &autoscaling.PodsMetricSource{
+			Metric: autoscaling.MetricIdentifier{Name: in.Pods.Metric.Name},
+			Target: convertV2beta2MetricTarget(in.Pods.Target),
+		}
+	}
+	if in.Object != nil {
+		out.Object = &autoscaling.ObjectMetricSource{
+			Metric: autoscaling.MetricIdentifier{Name: in.Object.Metric.Name},
+			Target: convertV2beta2MetricTarget(in.Object.Target),
+		}
+	}
+	if in.External != nil {
+		out.External = &autoscaling.ExternalMetricSource{
+			Metric: autoscaling.MetricIdentifier{Name: in.External.Metric.Name},
+			Target: convertV2beta2MetricTarget(in.External.Target),
+		}
+	}
+	return out
+}
+
+func convertV2beta2MetricStatus(in autoscalingv2beta2.MetricStatus) autoscaling.MetricStatus {
+	out := autoscaling.MetricStatus{Type: autoscaling.MetricSourceType(in.Type)}
+	if in.Resource != nil {
+		out.Resource = &autoscaling.ResourceMetricStatus{
+			Name:    in.Resource.Name,
+			Current: convertV2beta2MetricValueStatus(in.Resource.Current),
+		}
+	}
+	if in.ContainerResource != nil {
+		out.ContainerResource = &autoscaling.ContainerResourceMetricStatus{
+			Name:      in.ContainerResource.Name,
+			Container: in.ContainerResource.Container,
+			Current:   convertV2beta2MetricValueStatus(in.ContainerResource.Current),
+		}
+	}
+	if in.Pods != nil {
+		out.Pods = &autoscaling.PodsMetricStatus{
+			Metric:  autoscaling.MetricIdentifier{Name: in.Pods.Metric.Name},
+			Current: convertV2beta2MetricValueStatus(in.Pods.Current),
+		}
+	}
+	if in.Object != nil {
+		out.Object = &autoscaling.ObjectMetricStatus{
+			Metric:  autoscaling.MetricIdentifier{Name: in.Object.Metric.Name},
+			Current: convertV2beta2MetricValueStatus(in.Object.Current),
+		}
+	}
+	if in.External != nil {
+		out.External = &autoscaling.ExternalMetricStatus{
+			Metric:  autoscaling.MetricIdentifier{Name: in.External.Metric.Name},
+			Current: convertV2beta2MetricValueStatus(in.External.Current),
+		}
+	}
+	return out
+}
+
+// convertV1HPAList and convertV1HPA translate the minimal autoscaling/v1 HPA,
+// which only ever scales on CPU utilization, into a single Resource metric
+// entry so it flows through the same v2 GenerateFuncs as every other version.
+func convertV1HPAList(in *autoscalingv1.HorizontalPodAutoscalerList) *autoscaling.HorizontalPodAutoscalerList {
+	out := &autoscaling.HorizontalPodAutoscalerList{ListMeta: in.ListMeta}
+	for i := range in.Items {
+		out.Items = append(out.Items, *convertV1HPA(&in.Items[i]))
+	}
+	return out
+}
+
+func convertV1HPA(in *autoscalingv1.HorizontalPodAutoscaler) *autoscaling.HorizontalPodAutoscaler {
+	out := &autoscaling.HorizontalPodAutoscaler{
+		ObjectMeta: in.ObjectMeta,
+		Spec: autoscaling.HorizontalPodAutoscalerSpec{
+			MaxReplicas: in.Spec.MaxReplicas,
+			MinReplicas: in.Spec.MinReplicas,
 		},
-		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
-			return kubeClient.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).Watch(opts)
+		Status: autoscaling.HorizontalPodAutoscalerStatus{
+			CurrentReplicas:    in.Status.CurrentReplicas,
+			DesiredReplicas:    in.Status.DesiredReplicas,
+			LastScaleTime:      in.Status.LastScaleTime,
+			ObservedGeneration: in.Status.ObservedGeneration,
 		},
 	}
+	if v := in.Spec.TargetCPUUtilizationPercentage; v != nil {
+		out.Spec.Metrics = []autoscaling.MetricSpec{
+			{
+				Type: autoscaling.ResourceMetricSourceType,
+				Resource: &autoscaling.ResourceMetricSource{
+					Name: "cpu",
+					Target: autoscaling.MetricTarget{
+						Type:               autoscaling.UtilizationMetricType,
+						AverageUtilization: v,
+					},
+				},
+			},
+		}
+	}
+	if v := in.Status.CurrentCPUUtilizationPercentage; v != nil {
+		out.Status.CurrentMetrics = []autoscaling.MetricStatus{
+			{
+				Type: autoscaling.ResourceMetricSourceType,
+				Resource: &autoscaling.ResourceMetricStatus{
+					Name: "cpu",
+					Current: autoscaling.MetricValueStatus{
+						AverageUtilization: v,
+					},
+				},
+			},
+		}
+	}
+	return out
 }
 
 func generateMetricsFromMetricSpec(mss []autoscaling.MetricSpec) []*metric.Metric {
@@ -221,6 +631,26 @@ func generateMetricsFromMetricSpec(mss []autoscaling.MetricSpec) []*metric.Metri
 					out = append(out, m)
 				}
 			}
+		} else if ms.Type == autoscaling.ContainerResourceMetricSourceType {
+			m.LabelKeys = append(m.LabelKeys, "container", "name")
+			m.LabelValues = append(m.LabelValues, ms.ContainerResource.Container, string(ms.ContainerResource.Name))
+
+			mt := ms.ContainerResource.Target
+			if mt.Type == autoscaling.UtilizationMetricType {
+				if v := mt.AverageUtilization; v != nil {
+					m.LabelKeys = append(m.LabelKeys, "target_type")
+					m.LabelValues = append(m.LabelValues, "AverageUtilization")
+					m.Value = float64(*v)
+					out = append(out, m)
+				}
+			} else if mt.Type == autoscaling.AverageValueMetricType {
+				if v := mt.AverageValue; v != nil {
+					m.LabelKeys = append(m.LabelKeys, "target_type")
+					m.LabelValues = append(m.LabelValues, "AverageValue")
+					m.Value = float64(v.MilliValue()) / 1000.0
+					out = append(out, m)
+				}
+			}
 		} else if ms.Type == autoscaling.PodsMetricSourceType {
 			m.LabelKeys = append(m.LabelKeys, "metric_name")
 			m.LabelValues = append(m.LabelValues, ms.Pods.Metric.Name)
@@ -305,6 +735,22 @@ func generateMetricsFromMetricStatus(mss []autoscaling.MetricStatus) []*metric.M
 				m.Value = float64(v.MilliValue()) / 1000.0
 				out = append(out, m)
 			}
+		} else if ms.Type == autoscaling.ContainerResourceMetricSourceType {
+			m.LabelKeys = append(m.LabelKeys, "container", "name")
+			m.LabelValues = append(m.LabelValues, ms.ContainerResource.Container, string(ms.ContainerResource.Name))
+
+			mvs := ms.ContainerResource.Current
+			if v := mvs.AverageUtilization; v != nil {
+				m.LabelKeys = append(m.LabelKeys, "target_type")
+				m.LabelValues = append(m.LabelValues, "AverageUtilization")
+				m.Value = float64(*v)
+				out = append(out, m)
+			} else if v := mvs.AverageValue; v != nil {
+				m.LabelKeys = append(m.LabelKeys, "target_type")
+				m.LabelValues = append(m.LabelValues, "AverageValue")
+				m.Value = float64(v.MilliValue()) / 1000.0
+				out = append(out, m)
+			}
 		} else if ms.Type == autoscaling.PodsMetricSourceType {
 			m.LabelKeys = append(m.LabelKeys, "metric_name")
 			m.LabelValues = append(m.LabelValues, ms.Pods.Metric.Name)
@@ -355,3 +801,74 @@ func generateMetricsFromMetricStatus(mss []autoscaling.MetricStatus) []*metric.M
 
 	return out
 }
+
+func generateHPABehaviorStabilizationWindowMetrics(behavior *autoscaling.HorizontalPodAutoscalerBehavior) []*metric.Metric {
+	out := make([]*metric.Metric, 0)
+
+	for _, dr := range hpaScalingRulesByDirection(behavior) {
+		if dr.rules == nil || dr.rules.StabilizationWindowSeconds == nil {
+			continue
+		}
+		out = append(out, &metric.Metric{
+			LabelKeys:   []string{"direction"},
+			LabelValues: []string{dr.direction},
+			Value:       float64(*dr.rules.StabilizationWindowSeconds),
+		})
+	}
+
+	return out
+}
+
+func generateHPABehaviorSelectPolicyMetrics(behavior *autoscaling.HorizontalPodAutoscalerBehavior) []*metric.Metric {
+	out := make([]*metric.Metric, 0)
+
+	for _, dr := range hpaScalingRulesByDirection(behavior) {
+		if dr.rules == nil || dr.rules.SelectPolicy == nil {
+			continue
+		}
+		out = append(out, &metric.Metric{
+			LabelKeys:   []string{"direction", "policy"},
+			LabelValues: []string{dr.direction, string(*dr.rules.SelectPolicy)},
+			Value:       1,
+		})
+	}
+
+	return out
+}
+
+func generateHPABehaviorPolicyMetrics(behavior *autoscaling.HorizontalPodAutoscalerBehavior) []*metric.Metric {
+	out := make([]*metric.Metric, 0)
+
+	for _, dr := range hpaScalingRulesByDirection(behavior) {
+		if dr.rules == nil {
+			continue
+		}
+		for _, p := range dr.rules.Policies {
+			out = append(out, &metric.Metric{
+				LabelKeys:   []string{"direction", "policy_type", "period_seconds"},
+				LabelValues: []string{dr.direction, string(p.Type), strconv.Itoa(int(p.PeriodSeconds))},
+				Value:       float64(p.Value),
+			})
+		}
+	}
+
+	return out
+}
+
+// directionScalingRules pairs a behavior direction label with its rules, kept
+// as an ordered slice (rather than a map) so repeated scrapes emit series in
+// a stable order.
+type directionScalingRules struct {
+	direction string
+	rules     *autoscaling.HPAScalingRules
+}
+
+func hpaScalingRulesByDirection(behavior *autoscaling.HorizontalPodAutoscalerBehavior) []directionScalingRules {
+	if behavior == nil {
+		return nil
+	}
+	return []directionScalingRules{
+		{direction: "up", rules: behavior.ScaleUp},
+		{direction: "down", rules: behavior.ScaleDown},
+	}
+}
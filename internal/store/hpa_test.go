@@ -0,0 +1,379 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	autoscaling "k8s.io/api/autoscaling/v2"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGenerateMetricsFromMetricSpecContainerResource(t *testing.T) {
+	utilization := int32(60)
+
+	tests := []struct {
+		name            string
+		ms              autoscaling.MetricSpec
+		wantLabelKeys   []string
+		wantLabelValues []string
+		wantValue       float64
+	}{
+		{
+			name: "utilization target",
+			ms: autoscaling.MetricSpec{
+				Type: autoscaling.ContainerResourceMetricSourceType,
+				ContainerResource: &autoscaling.ContainerResourceMetricSource{
+					Name:      "cpu",
+					Container: "app",
+					Target: autoscaling.MetricTarget{
+						Type:               autoscaling.UtilizationMetricType,
+						AverageUtilization: &utilization,
+					},
+				},
+			},
+			wantLabelKeys:   []string{"type", "container", "name", "target_type"},
+			wantLabelValues: []string{"ContainerResource", "app", "cpu", "AverageUtilization"},
+			wantValue:       60,
+		},
+		{
+			name: "average value target",
+			ms: autoscaling.MetricSpec{
+				Type: autoscaling.ContainerResourceMetricSourceType,
+				ContainerResource: &autoscaling.ContainerResourceMetricSource{
+					Name:      "memory",
+					Container: "sidecar",
+					Target: autoscaling.MetricTarget{
+						Type:         autoscaling.AverageValueMetricType,
+						AverageValue: resource.NewQuantity(536870912, resource.BinarySI),
+					},
+				},
+			},
+			wantLabelKeys:   []string{"type", "container", "name", "target_type"},
+			wantLabelValues: []string{"ContainerResource", "sidecar", "memory", "AverageValue"},
+			wantValue:       536870912,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := generateMetricsFromMetricSpec([]autoscaling.MetricSpec{tt.ms})
+			if len(out) != 1 {
+				t.Fatalf("expected 1 metric, got %d", len(out))
+			}
+			m := out[0]
+			if !equalStringSlices(m.LabelKeys, tt.wantLabelKeys) {
+				t.Errorf("LabelKeys = %v, want %v", m.LabelKeys, tt.wantLabelKeys)
+			}
+			if !equalStringSlices(m.LabelValues, tt.wantLabelValues) {
+				t.Errorf("LabelValues = %v, want %v", m.LabelValues, tt.wantLabelValues)
+			}
+			if m.Value != tt.wantValue {
+				t.Errorf("Value = %v, want %v", m.Value, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestGenerateMetricsFromMetricStatusContainerResource(t *testing.T) {
+	utilization := int32(42)
+
+	tests := []struct {
+		name            string
+		ms              autoscaling.MetricStatus
+		wantLabelKeys   []string
+		wantLabelValues []string
+		wantValue       float64
+	}{
+		{
+			name: "utilization current",
+			ms: autoscaling.MetricStatus{
+				Type: autoscaling.ContainerResourceMetricSourceType,
+				ContainerResource: &autoscaling.ContainerResourceMetricStatus{
+					Name:      "cpu",
+					Container: "app",
+					Current: autoscaling.MetricValueStatus{
+						AverageUtilization: &utilization,
+					},
+				},
+			},
+			wantLabelKeys:   []string{"type", "container", "name", "target_type"},
+			wantLabelValues: []string{"ContainerResource", "app", "cpu", "AverageUtilization"},
+			wantValue:       42,
+		},
+		{
+			name: "average value current",
+			ms: autoscaling.MetricStatus{
+				Type: autoscaling.ContainerResourceMetricSourceType,
+				ContainerResource: &autoscaling.ContainerResourceMetricStatus{
+					Name:      "memory",
+					Container: "sidecar",
+					Current: autoscaling.MetricValueStatus{
+						AverageValue: resource.NewQuantity(1073741824, resource.BinarySI),
+					},
+				},
+			},
+			wantLabelKeys:   []string{"type", "container", "name", "target_type"},
+			wantLabelValues: []string{"ContainerResource", "sidecar", "memory", "AverageValue"},
+			wantValue:       1073741824,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := generateMetricsFromMetricStatus([]autoscaling.MetricStatus{tt.ms})
+			if len(out) != 1 {
+				t.Fatalf("expected 1 metric, got %d", len(out))
+			}
+			m := out[0]
+			if !equalStringSlices(m.LabelKeys, tt.wantLabelKeys) {
+				t.Errorf("LabelKeys = %v, want %v", m.LabelKeys, tt.wantLabelKeys)
+			}
+			if !equalStringSlices(m.LabelValues, tt.wantLabelValues) {
+				t.Errorf("LabelValues = %v, want %v", m.LabelValues, tt.wantLabelValues)
+			}
+			if m.Value != tt.wantValue {
+				t.Errorf("Value = %v, want %v", m.Value, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestPreferredAutoscalingVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []*metav1.APIResourceList
+		want      schema.GroupVersion
+	}{
+		{
+			name: "v2 served",
+			resources: []*metav1.APIResourceList{
+				{GroupVersion: "autoscaling/v2"},
+				{GroupVersion: "autoscaling/v2beta2"},
+				{GroupVersion: "autoscaling/v1"},
+			},
+			want: autoscalingV2Version,
+		},
+		{
+			name: "only v2beta2 served",
+			resources: []*metav1.APIResourceList{
+				{GroupVersion: "autoscaling/v2beta2"},
+				{GroupVersion: "autoscaling/v1"},
+			},
+			want: autoscalingV2beta2Version,
+		},
+		{
+			name: "only v1 served",
+			resources: []*metav1.APIResourceList{
+				{GroupVersion: "autoscaling/v1"},
+			},
+			want: autoscalingV1Version,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fakeclientset.NewSimpleClientset()
+			fakeDisc := client.Discovery().(*fakediscovery.FakeDiscovery)
+			fakeDisc.Resources = tt.resources
+
+			got := preferredAutoscalingVersion(client.Discovery())
+			if got != tt.want {
+				t.Errorf("preferredAutoscalingVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertV2beta2HPAMatchesV2Metrics(t *testing.T) {
+	utilization := int32(70)
+
+	v2beta2HPA := &autoscalingv2beta2.HorizontalPodAutoscaler{
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			MinReplicas: &utilization,
+			MaxReplicas: 10,
+			Metrics: []autoscalingv2beta2.MetricSpec{
+				{
+					Type: autoscalingv2beta2.ResourceMetricSourceType,
+					Resource: &autoscalingv2beta2.ResourceMetricSource{
+						Name: "cpu",
+						Target: autoscalingv2beta2.MetricTarget{
+							Type:               autoscalingv2beta2.UtilizationMetricType,
+							AverageUtilization: &utilization,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	converted := convertV2beta2HPA(v2beta2HPA)
+	got := generateMetricsFromMetricSpec(converted.Spec.Metrics)
+
+	want := generateMetricsFromMetricSpec([]autoscaling.MetricSpec{
+		{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name: "cpu",
+				Target: autoscaling.MetricTarget{
+					Type:               autoscaling.UtilizationMetricType,
+					AverageUtilization: &utilization,
+				},
+			},
+		},
+	})
+
+	if len(got) != 1 || len(want) != 1 {
+		t.Fatalf("got %d metrics, want %d", len(got), len(want))
+	}
+	if !equalStringSlices(got[0].LabelKeys, want[0].LabelKeys) {
+		t.Errorf("LabelKeys = %v, want %v", got[0].LabelKeys, want[0].LabelKeys)
+	}
+	if !equalStringSlices(got[0].LabelValues, want[0].LabelValues) {
+		t.Errorf("LabelValues = %v, want %v", got[0].LabelValues, want[0].LabelValues)
+	}
+	if got[0].Value != want[0].Value {
+		t.Errorf("Value = %v, want %v", got[0].Value, want[0].Value)
+	}
+}
+
+func TestConvertV1HPAMatchesV2Metrics(t *testing.T) {
+	utilization := int32(55)
+
+	v1HPA := &autoscalingv1.HorizontalPodAutoscaler{
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			TargetCPUUtilizationPercentage: &utilization,
+		},
+	}
+
+	converted := convertV1HPA(v1HPA)
+	got := generateMetricsFromMetricSpec(converted.Spec.Metrics)
+
+	want := generateMetricsFromMetricSpec([]autoscaling.MetricSpec{
+		{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name: "cpu",
+				Target: autoscaling.MetricTarget{
+					Type:               autoscaling.UtilizationMetricType,
+					AverageUtilization: &utilization,
+				},
+			},
+		},
+	})
+
+	if len(got) != 1 || len(want) != 1 {
+		t.Fatalf("got %d metrics, want %d", len(got), len(want))
+	}
+	if !equalStringSlices(got[0].LabelKeys, want[0].LabelKeys) {
+		t.Errorf("LabelKeys = %v, want %v", got[0].LabelKeys, want[0].LabelKeys)
+	}
+	if !equalStringSlices(got[0].LabelValues, want[0].LabelValues) {
+		t.Errorf("LabelValues = %v, want %v", got[0].LabelValues, want[0].LabelValues)
+	}
+	if got[0].Value != want[0].Value {
+		t.Errorf("Value = %v, want %v", got[0].Value, want[0].Value)
+	}
+}
+
+func TestHPAStatusFamiliesNilLastScaleTimeAndObservedGeneration(t *testing.T) {
+	hpa := &autoscaling.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 3,
+		},
+		Status: autoscaling.HorizontalPodAutoscalerStatus{
+			LastScaleTime:      nil,
+			ObservedGeneration: nil,
+		},
+	}
+
+	for _, name := range []string{
+		"kube_hpa_status_last_scale_time",
+		"kube_hpa_status_observed_generation",
+		"kube_hpa_generation_lag",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fam := hpaFamilyByName(t, name)
+			family := fam.GenerateFunc(hpa)
+			if len(family.Metrics) != 0 {
+				t.Errorf("%s: expected no series when underlying field is nil, got %d", name, len(family.Metrics))
+			}
+		})
+	}
+}
+
+func TestHPAStatusLastScaleTimeSet(t *testing.T) {
+	scaleTime := metav1.NewTime(time.Unix(1700000000, 0))
+	observedGeneration := int64(3)
+
+	hpa := &autoscaling.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 5,
+		},
+		Status: autoscaling.HorizontalPodAutoscalerStatus{
+			LastScaleTime:      &scaleTime,
+			ObservedGeneration: &observedGeneration,
+		},
+	}
+
+	lastScaleTimeFam := hpaFamilyByName(t, "kube_hpa_status_last_scale_time").GenerateFunc(hpa)
+	if len(lastScaleTimeFam.Metrics) != 1 || lastScaleTimeFam.Metrics[0].Value != float64(1700000000) {
+		t.Errorf("kube_hpa_status_last_scale_time = %+v, want a single series with value 1700000000", lastScaleTimeFam.Metrics)
+	}
+
+	observedGenerationFam := hpaFamilyByName(t, "kube_hpa_status_observed_generation").GenerateFunc(hpa)
+	if len(observedGenerationFam.Metrics) != 1 || observedGenerationFam.Metrics[0].Value != 3 {
+		t.Errorf("kube_hpa_status_observed_generation = %+v, want a single series with value 3", observedGenerationFam.Metrics)
+	}
+
+	lagFam := hpaFamilyByName(t, "kube_hpa_generation_lag").GenerateFunc(hpa)
+	if len(lagFam.Metrics) != 1 || lagFam.Metrics[0].Value != 2 {
+		t.Errorf("kube_hpa_generation_lag = %+v, want a single series with value 2", lagFam.Metrics)
+	}
+}
+
+func hpaFamilyByName(t *testing.T, name string) metric.FamilyGenerator {
+	t.Helper()
+	for _, fam := range hpaMetricFamilies {
+		if fam.Name == name {
+			return fam
+		}
+	}
+	t.Fatalf("no hpa metric family named %q", name)
+	return metric.FamilyGenerator{}
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
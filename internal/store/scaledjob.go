@@ -0,0 +1,165 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+)
+
+// scaledJobGVR identifies the KEDA CRD backing the scaledobjects
+// collector's ScaledJob half. Gated behind discovery the same way as
+// scaledObjectGVR.
+var scaledJobGVR = schema.GroupVersionResource{Group: "keda.sh", Version: "v1alpha1", Resource: "scaledjobs"}
+
+var (
+	descScaledJobLabelsName          = "kube_scaledjob_labels"
+	descScaledJobLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descScaledJobLabelsDefaultLabels = []string{"namespace", "scaledjob"}
+
+	scaledJobMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: descScaledJobLabelsName,
+			Type: metric.Gauge,
+			Help: descScaledJobLabelsHelp,
+			GenerateFunc: wrapScaledJobFunc(func(s *unstructured.Unstructured) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(s.GetLabels())
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_scaledjob_spec_min_replicas",
+			Type: metric.Gauge,
+			Help: "Minimum number of jobs the ScaledJob runs concurrently.",
+			GenerateFunc: wrapScaledJobFunc(func(s *unstructured.Unstructured) *metric.Family {
+				ms := make([]*metric.Metric, 0)
+				if v, ok, err := unstructured.NestedInt64(s.Object, "spec", "minReplicaCount"); err == nil && ok {
+					ms = append(ms, &metric.Metric{Value: float64(v)})
+				}
+				return &metric.Family{Metrics: ms}
+			}),
+		},
+		{
+			Name: "kube_scaledjob_spec_max_replicas",
+			Type: metric.Gauge,
+			Help: "Maximum number of jobs the ScaledJob runs concurrently.",
+			GenerateFunc: wrapScaledJobFunc(func(s *unstructured.Unstructured) *metric.Family {
+				ms := make([]*metric.Metric, 0)
+				if v, ok, err := unstructured.NestedInt64(s.Object, "spec", "maxReplicaCount"); err == nil && ok {
+					ms = append(ms, &metric.Metric{Value: float64(v)})
+				}
+				return &metric.Family{Metrics: ms}
+			}),
+		},
+		{
+			Name: "kube_scaledjob_spec_trigger",
+			Type: metric.Gauge,
+			Help: "Each configured scale trigger for the ScaledJob.",
+			GenerateFunc: wrapScaledJobFunc(func(s *unstructured.Unstructured) *metric.Family {
+				return &metric.Family{
+					Metrics: generateScaledTriggerMetrics(s),
+				}
+			}),
+		},
+		{
+			Name: "kube_scaledjob_status_active",
+			Type: metric.Gauge,
+			Help: "Whether the ScaledJob's Active condition is currently true.",
+			GenerateFunc: wrapScaledJobFunc(func(s *unstructured.Unstructured) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{Value: boolFloat64(scaledConditionTrue(s, "Active"))},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_scaledjob_status_paused",
+			Type: metric.Gauge,
+			Help: "Whether the ScaledJob's Paused condition is currently true.",
+			GenerateFunc: wrapScaledJobFunc(func(s *unstructured.Unstructured) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{Value: boolFloat64(scaledConditionTrue(s, "Paused"))},
+					},
+				}
+			}),
+		},
+	}
+)
+
+func wrapScaledJobFunc(f func(*unstructured.Unstructured) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		scaledJob := obj.(*unstructured.Unstructured)
+
+		metricFamily := f(scaledJob)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descScaledJobLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{scaledJob.GetNamespace(), scaledJob.GetName()}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+// scaledJobCRDAvailable reports whether the keda.sh/v1alpha1 API group is
+// served by the cluster and lists the scaledjobs resource, so the
+// scaledobjects collector can skip ScaledJobs cleanly when KEDA isn't
+// installed.
+func scaledJobCRDAvailable(disc discovery.DiscoveryInterface) bool {
+	list, err := disc.ServerResourcesForGroupVersion(scaledJobGVR.GroupVersion().String())
+	if err != nil || list == nil {
+		return false
+	}
+	for _, r := range list.APIResources {
+		if r.Name == scaledJobGVR.Resource {
+			return true
+		}
+	}
+	return false
+}
+
+func createScaledJobListWatch(dynamicClient dynamic.Interface, ns string) cache.ListerWatcher {
+	resource := dynamicClient.Resource(scaledJobGVR).Namespace(ns)
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return resource.List(context.TODO(), opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return resource.Watch(context.TODO(), opts)
+		},
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+)
+
+// scaledObjectGVR identifies the KEDA CRD backing the scaledobjects
+// collector. The collector is gated behind discovery of this GVR so it
+// degrades cleanly on clusters that don't run KEDA.
+var scaledObjectGVR = schema.GroupVersionResource{Group: "keda.sh", Version: "v1alpha1", Resource: "scaledobjects"}
+
+var (
+	descScaledObjectLabelsName          = "kube_scaledobject_labels"
+	descScaledObjectLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descScaledObjectLabelsDefaultLabels = []string{"namespace", "scaledobject"}
+
+	scaledObjectMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: descScaledObjectLabelsName,
+			Type: metric.Gauge,
+			Help: descScaledObjectLabelsHelp,
+			GenerateFunc: wrapScaledObjectFunc(func(s *unstructured.Unstructured) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(s.GetLabels())
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_scaledobject_spec_min_replicas",
+			Type: metric.Gauge,
+			Help: "Minimum number of replicas the ScaledObject's target can be scaled down to.",
+			GenerateFunc: wrapScaledObjectFunc(func(s *unstructured.Unstructured) *metric.Family {
+				ms := make([]*metric.Metric, 0)
+				if v, ok, err := unstructured.NestedInt64(s.Object, "spec", "minReplicaCount"); err == nil && ok {
+					ms = append(ms, &metric.Metric{Value: float64(v)})
+				}
+				return &metric.Family{Metrics: ms}
+			}),
+		},
+		{
+			Name: "kube_scaledobject_spec_max_replicas",
+			Type: metric.Gauge,
+			Help: "Maximum number of replicas the ScaledObject's target can be scaled up to.",
+			GenerateFunc: wrapScaledObjectFunc(func(s *unstructured.Unstructured) *metric.Family {
+				ms := make([]*metric.Metric, 0)
+				if v, ok, err := unstructured.NestedInt64(s.Object, "spec", "maxReplicaCount"); err == nil && ok {
+					ms = append(ms, &metric.Metric{Value: float64(v)})
+				}
+				return &metric.Family{Metrics: ms}
+			}),
+		},
+		{
+			Name: "kube_scaledobject_spec_trigger",
+			Type: metric.Gauge,
+			Help: "Each configured scale trigger for the ScaledObject.",
+			GenerateFunc: wrapScaledObjectFunc(func(s *unstructured.Unstructured) *metric.Family {
+				return &metric.Family{
+					Metrics: generateScaledTriggerMetrics(s),
+				}
+			}),
+		},
+		{
+			Name: "kube_scaledobject_status_active",
+			Type: metric.Gauge,
+			Help: "Whether the ScaledObject's Active condition is currently true.",
+			GenerateFunc: wrapScaledObjectFunc(func(s *unstructured.Unstructured) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{Value: boolFloat64(scaledConditionTrue(s, "Active"))},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_scaledobject_status_paused",
+			Type: metric.Gauge,
+			Help: "Whether the ScaledObject's Paused condition is currently true.",
+			GenerateFunc: wrapScaledObjectFunc(func(s *unstructured.Unstructured) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{Value: boolFloat64(scaledConditionTrue(s, "Paused"))},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_scaledobject_status_health",
+			Type: metric.Gauge,
+			Help: "Health of each trigger tracked in the ScaledObject's status, as reported by status.health.",
+			GenerateFunc: wrapScaledObjectFunc(func(s *unstructured.Unstructured) *metric.Family {
+				return &metric.Family{
+					Metrics: generateScaledHealthMetrics(s),
+				}
+			}),
+		},
+	}
+)
+
+func wrapScaledObjectFunc(f func(*unstructured.Unstructured) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		scaledObject := obj.(*unstructured.Unstructured)
+
+		metricFamily := f(scaledObject)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descScaledObjectLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{scaledObject.GetNamespace(), scaledObject.GetName()}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+// scaledObjectCRDAvailable reports whether the keda.sh/v1alpha1 API group is
+// served by the cluster and lists the scaledobjects resource, so the
+// scaledobjects collector can be skipped cleanly when KEDA isn't installed.
+func scaledObjectCRDAvailable(disc discovery.DiscoveryInterface) bool {
+	list, err := disc.ServerResourcesForGroupVersion(scaledObjectGVR.GroupVersion().String())
+	if err != nil || list == nil {
+		return false
+	}
+	for _, r := range list.APIResources {
+		if r.Name == scaledObjectGVR.Resource {
+			return true
+		}
+	}
+	return false
+}
+
+func createScaledObjectListWatch(dynamicClient dynamic.Interface, ns string) cache.ListerWatcher {
+	resource := dynamicClient.Resource(scaledObjectGVR).Namespace(ns)
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return resource.List(context.TODO(), opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return resource.Watch(context.TODO(), opts)
+		},
+	}
+}
+
+// scaledConditionTrue looks up a condition by type in status.conditions,
+// which KEDA populates the same way core Kubernetes objects do.
+func scaledConditionTrue(obj *unstructured.Unstructured, condType string) bool {
+	conditions, ok, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !ok {
+		return false
+	}
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != condType {
+			continue
+		}
+		status, _ := cond["status"].(string)
+		return status == "True"
+	}
+	return false
+}
+
+func generateScaledTriggerMetrics(obj *unstructured.Unstructured) []*metric.Metric {
+	out := make([]*metric.Metric, 0)
+
+	triggers, ok, err := unstructured.NestedSlice(obj.Object, "spec", "triggers")
+	if err != nil || !ok {
+		return out
+	}
+
+	for _, t := range triggers {
+		trigger, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		triggerType, _ := trigger["type"].(string)
+		name, _ := trigger["name"].(string)
+		metricType, _ := trigger["metricType"].(string)
+
+		out = append(out, &metric.Metric{
+			LabelKeys:   []string{"type", "name", "metric_type"},
+			LabelValues: []string{triggerType, name, metricType},
+			Value:       1,
+		})
+	}
+
+	return out
+}
+
+func generateScaledHealthMetrics(obj *unstructured.Unstructured) []*metric.Metric {
+	out := make([]*metric.Metric, 0)
+
+	health, ok, err := unstructured.NestedMap(obj.Object, "status", "health")
+	if err != nil || !ok {
+		return out
+	}
+
+	triggers := make([]string, 0, len(health))
+	for trigger := range health {
+		triggers = append(triggers, trigger)
+	}
+	sort.Strings(triggers)
+
+	for _, trigger := range triggers {
+		entry, ok := health[trigger].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		status, _ := entry["status"].(string)
+
+		out = append(out, &metric.Metric{
+			LabelKeys:   []string{"trigger"},
+			LabelValues: []string{trigger},
+			Value:       boolFloat64(status == "Happy"),
+		})
+	}
+
+	return out
+}
+
+func boolFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CollectorName names a single kube-state-metrics resource collector, as
+// accepted by the --collectors flag.
+type CollectorName string
+
+const (
+	CollectorHorizontalPodAutoscalers CollectorName = "horizontalpodautoscalers"
+	CollectorScaledObjects            CollectorName = "scaledobjects"
+)
+
+// DefaultCollectors is the set of collectors enabled when --collectors is
+// not specified.
+var DefaultCollectors = CollectorSet{
+	CollectorHorizontalPodAutoscalers: struct{}{},
+}
+
+// CollectorSet is a pflag.Value backed by a set of collector names, so it can
+// be populated straight off a comma-separated --collectors flag.
+type CollectorSet map[CollectorName]struct{}
+
+func (c CollectorSet) String() string {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func (c *CollectorSet) Set(value string) error {
+	names := strings.Split(value, ",")
+	collectors := CollectorSet{}
+	for _, name := range names {
+		trimmed := CollectorName(strings.TrimSpace(name))
+		if trimmed == "" {
+			continue
+		}
+		collectors[trimmed] = struct{}{}
+	}
+	*c = collectors
+	return nil
+}
+
+func (c *CollectorSet) Type() string {
+	return "string"
+}
+
+// IsEnabled reports whether the given collector was requested.
+func (c CollectorSet) IsEnabled(name CollectorName) bool {
+	_, ok := c[name]
+	return ok
+}
+
+var _ fmt.Stringer = CollectorSet{}
@@ -0,0 +1,39 @@
+/*
+Copyright 2016 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// Options holds the flag-controlled configuration for kube-state-metrics.
+type Options struct {
+	Collectors CollectorSet
+}
+
+// NewOptions returns an Options with the default set of collectors enabled.
+func NewOptions() *Options {
+	return &Options{
+		Collectors: DefaultCollectors,
+	}
+}
+
+// AddFlags registers the flags kube-state-metrics' entrypoint should bind to
+// this Options.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.Var(&o.Collectors, "collectors", "Comma-separated list of collectors to be enabled. Defaults to \""+DefaultCollectors.String()+"\".")
+}